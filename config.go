@@ -0,0 +1,235 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/ttys3/tracing/filter"
+)
+
+// Exporter selects which tracing backend Init should wire up.
+type Exporter string
+
+const (
+	ExporterStdout   Exporter = "stdout"
+	ExporterOTLPGRPC Exporter = "otlp_grpc"
+	ExporterOTLPHTTP Exporter = "otlp_http"
+	ExporterJaeger   Exporter = "jaeger"
+)
+
+// SamplerRatioAlwaysOff is a sentinel for Config.SamplerRatio meaning
+// "sample nothing", distinct from the zero value (which means "use the
+// default", i.e. always-on).
+const SamplerRatioAlwaysOff = -1
+
+// Config describes everything needed to stand up a TracerProvider for a
+// given service, regardless of which backend it ends up exporting to.
+type Config struct {
+	// Enabled allows callers to keep a Config around without having to
+	// special-case the call site: when false, Init is a no-op and Reload
+	// shuts down the running TracerProvider.
+	Enabled bool
+
+	Exporter Exporter
+
+	// Endpoint is required for all Exporter values except ExporterStdout.
+	Endpoint string
+	// Insecure is a tri-state: nil means "not explicitly set", so Init's
+	// env-var auto-detection (see DetectFromEnv) is free to fill it in.
+	// Use Bool(true)/Bool(false) to pin it explicitly. Unset and
+	// undetected both mean secure (TLS) transport.
+	Insecure *bool
+	Headers  map[string]string
+
+	ServiceName           string
+	ServiceVersion        string
+	ServiceInstanceID     string
+	DeploymentEnvironment string
+	Attributes            []attribute.KeyValue
+
+	// Propagators selects which TextMapPropagators are composed into the
+	// global propagator. Defaults to W3C tracecontext + baggage.
+	Propagators []Propagator
+
+	// SamplerRatio is the fraction of traces to sample, in [0, 1]. Zero
+	// means "use the default", which is always-on to match the historical
+	// behavior of this package. Use SamplerRatioAlwaysOff to sample nothing
+	// instead of falling back to that default.
+	SamplerRatio float64
+
+	DurationFilter bool
+	DurationMin    time.Duration
+	DurationMax    time.Duration
+
+	// ExtraSpanProcessors are installed on the TracerProvider alongside the
+	// batch processor built from Exporter, e.g. a
+	// recorder.RecordingSpanProcessor for capturing traces in-process.
+	ExtraSpanProcessors []sdktrace.SpanProcessor
+}
+
+// Bool returns a pointer to v, for use with Config.Insecure.
+func Bool(v bool) *bool { return &v }
+
+// insecure reports the effective value of Insecure, treating "not set" as
+// secure (false).
+func (c Config) insecure() bool {
+	return c.Insecure != nil && *c.Insecure
+}
+
+// Validate checks that the Config is internally consistent before it is
+// used to build a TracerProvider.
+func (c Config) Validate() error {
+	if c.ServiceName == "" {
+		return fmt.Errorf("tracing: service name is required")
+	}
+	switch c.Exporter {
+	case ExporterStdout:
+	case ExporterOTLPGRPC, ExporterOTLPHTTP, ExporterJaeger:
+		if c.Endpoint == "" {
+			return fmt.Errorf("tracing: endpoint is required for exporter %q", c.Exporter)
+		}
+	default:
+		return fmt.Errorf("tracing: unknown exporter %q", c.Exporter)
+	}
+	if c.SamplerRatio != SamplerRatioAlwaysOff && (c.SamplerRatio < 0 || c.SamplerRatio > 1) {
+		return fmt.Errorf("tracing: sampler ratio must be in [0, 1], got %v", c.SamplerRatio)
+	}
+	return nil
+}
+
+// Init builds and installs a TracerProvider for cfg, setting it as the
+// global otel TracerProvider. InitOtlpTracerProvider is a shim over Init;
+// InitStdoutTracerProvider builds its own provider and does not call Init.
+func Init(ctx context.Context, cfg Config) (TpShutdownFunc, error) {
+	if !cfg.Enabled {
+		setCurrentConfig(cfg)
+		return emptyTpShutdownFunc, nil
+	}
+
+	if cfg.Endpoint == "" {
+		cfg = mergeDetected(cfg, DetectFromEnv())
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return emptyTpShutdownFunc, err
+	}
+
+	otel.SetErrorHandler(&otelErrorHandler{})
+
+	traceExp, err := newExporter(ctx, cfg)
+	if err != nil {
+		return emptyTpShutdownFunc, fmt.Errorf("failed to create the %s trace exporter (%w)", cfg.Exporter, err)
+	}
+
+	res, err := newResource(ctx, cfg)
+	if err != nil {
+		return emptyTpShutdownFunc, fmt.Errorf("failed to create resource (%w)", err)
+	}
+
+	var spanProcessor sdktrace.SpanProcessor = sdktrace.NewBatchSpanProcessor(traceExp,
+		sdktrace.WithBatchTimeout(5*time.Second),
+		sdktrace.WithMaxExportBatchSize(10),
+	)
+	if cfg.DurationFilter {
+		spanProcessor = filter.DurationFilter{
+			Next: spanProcessor,
+			Min:  cfg.DurationMin,
+			Max:  cfg.DurationMax,
+		}
+	}
+
+	propagator, err := buildPropagator(cfg.Propagators)
+	if err != nil {
+		return emptyTpShutdownFunc, err
+	}
+
+	tpOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(samplerFor(cfg.SamplerRatio))),
+		sdktrace.WithSpanProcessor(spanProcessor),
+		sdktrace.WithResource(res),
+	}
+	for _, p := range cfg.ExtraSpanProcessors {
+		tpOpts = append(tpOpts, sdktrace.WithSpanProcessor(p))
+	}
+
+	tp := sdktrace.NewTracerProvider(tpOpts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagator)
+
+	setCurrentConfig(cfg)
+	return tp.Shutdown, nil
+}
+
+func samplerFor(ratio float64) sdktrace.Sampler {
+	if ratio == SamplerRatioAlwaysOff {
+		return sdktrace.TraceIDRatioBased(0)
+	}
+	if ratio <= 0 {
+		return sdktrace.AlwaysSample()
+	}
+	return sdktrace.TraceIDRatioBased(ratio)
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLPGRPC:
+		expOptions := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+		}
+		if cfg.insecure() {
+			expOptions = append(expOptions, otlptracegrpc.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			expOptions = append(expOptions, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+
+		grpcConnectionTimeout := 3 * time.Second
+		ctx, cancel := context.WithTimeout(ctx, grpcConnectionTimeout)
+		defer cancel()
+		return otlptracegrpc.New(ctx, expOptions...)
+	case ExporterOTLPHTTP:
+		expOptions := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(cfg.Endpoint),
+		}
+		if cfg.insecure() {
+			expOptions = append(expOptions, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			expOptions = append(expOptions, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		return otlptracehttp.New(ctx, expOptions...)
+	case ExporterJaeger:
+		return jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(cfg.Endpoint)))
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+}
+
+func newResource(ctx context.Context, cfg Config) (*resource.Resource, error) {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(cfg.ServiceName),
+		semconv.ServiceVersionKey.String(cfg.ServiceVersion),
+	}
+	if cfg.ServiceInstanceID != "" {
+		attrs = append(attrs, semconv.ServiceInstanceIDKey.String(cfg.ServiceInstanceID))
+	}
+	if cfg.DeploymentEnvironment != "" {
+		attrs = append(attrs, semconv.DeploymentEnvironmentKey.String(cfg.DeploymentEnvironment))
+	}
+	attrs = append(attrs, cfg.Attributes...)
+
+	return resource.New(ctx, resource.WithAttributes(attrs...))
+}
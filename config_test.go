@@ -0,0 +1,43 @@
+package tracing
+
+import "testing"
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"missing service name", Config{Exporter: ExporterStdout}, true},
+		{"stdout needs no endpoint", Config{ServiceName: "svc", Exporter: ExporterStdout}, false},
+		{"otlp grpc missing endpoint", Config{ServiceName: "svc", Exporter: ExporterOTLPGRPC}, true},
+		{"otlp grpc with endpoint", Config{ServiceName: "svc", Exporter: ExporterOTLPGRPC, Endpoint: "collector:4317"}, false},
+		{"otlp http missing endpoint", Config{ServiceName: "svc", Exporter: ExporterOTLPHTTP}, true},
+		{"jaeger missing endpoint", Config{ServiceName: "svc", Exporter: ExporterJaeger}, true},
+		{"unknown exporter", Config{ServiceName: "svc", Exporter: "bogus"}, true},
+		{"sampler ratio too high", Config{ServiceName: "svc", Exporter: ExporterStdout, SamplerRatio: 1.5}, true},
+		{"sampler ratio negative", Config{ServiceName: "svc", Exporter: ExporterStdout, SamplerRatio: -0.5}, true},
+		{"sampler ratio always off sentinel", Config{ServiceName: "svc", Exporter: ExporterStdout, SamplerRatio: SamplerRatioAlwaysOff}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSamplerFor(t *testing.T) {
+	if got := samplerFor(0).Description(); got != "AlwaysOnSampler" {
+		t.Errorf("samplerFor(0).Description() = %q, want AlwaysOnSampler", got)
+	}
+	if got := samplerFor(SamplerRatioAlwaysOff).Description(); got != "TraceIDRatioBased{0}" {
+		t.Errorf("samplerFor(SamplerRatioAlwaysOff).Description() = %q, want TraceIDRatioBased{0}", got)
+	}
+	if got := samplerFor(0.5).Description(); got != "TraceIDRatioBased{0.5}" {
+		t.Errorf("samplerFor(0.5).Description() = %q, want TraceIDRatioBased{0.5}", got)
+	}
+}
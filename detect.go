@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Standard OTel SDK environment variables, see
+// https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/
+const (
+	envOTLPEndpoint  = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envOTLPProtocol  = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	envOTLPHeaders   = "OTEL_EXPORTER_OTLP_HEADERS"
+	envOTLPInsecure  = "OTEL_EXPORTER_OTLP_INSECURE"
+	envServiceName   = "OTEL_SERVICE_NAME"
+	envResourceAttrs = "OTEL_RESOURCE_ATTRIBUTES"
+	envSampler       = "OTEL_TRACES_SAMPLER"
+	envSamplerArg    = "OTEL_TRACES_SAMPLER_ARG"
+)
+
+// DetectFromEnv builds a Config from the standard OTel SDK environment
+// variables. Init calls this automatically whenever it is given a Config
+// with no Endpoint set, so a service can be made drop-in for containerized
+// deployments without any flag-driven wiring: the collector endpoint,
+// protocol, headers, service name, resource attributes and sampler are all
+// picked up from the environment.
+//
+// Fields DetectFromEnv can't determine from the environment (e.g. Endpoint,
+// when OTEL_EXPORTER_OTLP_ENDPOINT is unset) are left at their zero value.
+func DetectFromEnv() Config {
+	var cfg Config
+
+	cfg.Endpoint = os.Getenv(envOTLPEndpoint)
+
+	// The OTel SDK environment variable spec defaults OTEL_EXPORTER_OTLP_PROTOCOL
+	// to "http/protobuf" when unset, not "grpc":
+	// https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/
+	switch strings.ToLower(os.Getenv(envOTLPProtocol)) {
+	case "grpc":
+		cfg.Exporter = ExporterOTLPGRPC
+	case "http/protobuf", "http/json", "":
+		cfg.Exporter = ExporterOTLPHTTP
+	}
+
+	if insecure, err := strconv.ParseBool(os.Getenv(envOTLPInsecure)); err == nil {
+		cfg.Insecure = Bool(insecure)
+	}
+
+	if headers := os.Getenv(envOTLPHeaders); headers != "" {
+		cfg.Headers = parseKeyValueList(headers)
+	}
+
+	cfg.ServiceName = os.Getenv(envServiceName)
+
+	if attrs := os.Getenv(envResourceAttrs); attrs != "" {
+		for k, v := range parseKeyValueList(attrs) {
+			cfg.Attributes = append(cfg.Attributes, attribute.String(k, v))
+		}
+	}
+
+	cfg.SamplerRatio = samplerRatioFromEnv(os.Getenv(envSampler), os.Getenv(envSamplerArg))
+
+	return cfg
+}
+
+func samplerRatioFromEnv(sampler, arg string) float64 {
+	switch sampler {
+	case "always_off", "parentbased_always_off":
+		return SamplerRatioAlwaysOff
+	}
+	if !strings.Contains(sampler, "ratio") {
+		return 0
+	}
+	ratio, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0
+	}
+	if ratio == 0 {
+		// A zero Config.SamplerRatio means always-on, not "sample nothing".
+		return SamplerRatioAlwaysOff
+	}
+	return ratio
+}
+
+// parseKeyValueList parses a comma-separated list of key=value pairs, the
+// format used by OTEL_EXPORTER_OTLP_HEADERS and OTEL_RESOURCE_ATTRIBUTES.
+func parseKeyValueList(s string) map[string]string {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return out
+}
+
+// mergeDetected fills any zero-valued field of cfg from detected, so an
+// explicitly set field always wins over what was auto-detected.
+func mergeDetected(cfg, detected Config) Config {
+	if cfg.Exporter == "" {
+		cfg.Exporter = detected.Exporter
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = detected.Endpoint
+	}
+	if cfg.Insecure == nil {
+		cfg.Insecure = detected.Insecure
+	}
+	if len(cfg.Headers) == 0 {
+		cfg.Headers = detected.Headers
+	}
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = detected.ServiceName
+	}
+	if len(cfg.Attributes) == 0 {
+		cfg.Attributes = detected.Attributes
+	}
+	if cfg.SamplerRatio == 0 {
+		cfg.SamplerRatio = detected.SamplerRatio
+	}
+	return cfg
+}
@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParseKeyValueList(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want map[string]string
+	}{
+		{"empty", "", map[string]string{}},
+		{"single", "a=b", map[string]string{"a": "b"}},
+		{"multiple with spaces", "a=b, c=d ,e=f", map[string]string{"a": "b", "c": "d", "e": "f"}},
+		{"missing equals is skipped", "a=b,nomatch,c=d", map[string]string{"a": "b", "c": "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseKeyValueList(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseKeyValueList(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamplerRatioFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		sampler string
+		arg     string
+		want    float64
+	}{
+		{"no sampler", "", "", 0},
+		{"always_on", "always_on", "", 0},
+		{"always_off", "always_off", "", SamplerRatioAlwaysOff},
+		{"parentbased_always_off", "parentbased_always_off", "", SamplerRatioAlwaysOff},
+		{"traceidratio", "traceidratio", "0.25", 0.25},
+		{"parentbased_traceidratio", "parentbased_traceidratio", "0.5", 0.5},
+		{"invalid arg falls back to 0", "traceidratio", "not-a-number", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := samplerRatioFromEnv(tt.sampler, tt.arg); got != tt.want {
+				t.Errorf("samplerRatioFromEnv(%q, %q) = %v, want %v", tt.sampler, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeDetectedPrefersExplicitValues(t *testing.T) {
+	explicit := Config{
+		ServiceName: "my-service",
+		Insecure:    Bool(false),
+	}
+	detected := Config{
+		ServiceName: "env-service",
+		Endpoint:    "collector:4317",
+		// Simulates OTEL_EXPORTER_OTLP_INSECURE=true being set in the
+		// environment, e.g. by a shared container base image.
+		Insecure: Bool(true),
+	}
+
+	got := mergeDetected(explicit, detected)
+
+	if got.ServiceName != "my-service" {
+		t.Errorf("ServiceName = %q, want explicit value %q", got.ServiceName, "my-service")
+	}
+	if got.Endpoint != "collector:4317" {
+		t.Errorf("Endpoint = %q, want detected value filled in", got.Endpoint)
+	}
+	if got.insecure() {
+		t.Errorf("insecure() = true, want explicit Insecure: Bool(false) to win over a detected true")
+	}
+}
+
+func TestMergeDetectedFillsUnsetInsecure(t *testing.T) {
+	cfg := Config{ServiceName: "svc"}
+	detected := Config{Insecure: Bool(true)}
+
+	got := mergeDetected(cfg, detected)
+
+	if !got.insecure() {
+		t.Errorf("insecure() = false, want detected true to fill an unset field")
+	}
+}
+
+func TestDetectFromEnvProtocolDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		want     Exporter
+	}{
+		// Per the OTel SDK environment variable spec, an unset
+		// OTEL_EXPORTER_OTLP_PROTOCOL defaults to http/protobuf, not grpc.
+		{"unset defaults to http", "", ExporterOTLPHTTP},
+		{"http/protobuf", "http/protobuf", ExporterOTLPHTTP},
+		{"http/json", "http/json", ExporterOTLPHTTP},
+		{"grpc", "grpc", ExporterOTLPGRPC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.protocol == "" {
+				os.Unsetenv(envOTLPProtocol)
+			} else {
+				os.Setenv(envOTLPProtocol, tt.protocol)
+			}
+			defer os.Unsetenv(envOTLPProtocol)
+
+			if got := DetectFromEnv().Exporter; got != tt.want {
+				t.Errorf("DetectFromEnv().Exporter = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
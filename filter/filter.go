@@ -0,0 +1,43 @@
+// Package filter provides SpanProcessor wrappers that gate which spans
+// reach the next processor in the chain.
+package filter
+
+import (
+	"context"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// DurationFilter only forwards spans to Next whose duration falls within
+// [Min, Max], so short noise and runaway outliers never reach the
+// configured exporter.
+type DurationFilter struct {
+	Next sdktrace.SpanProcessor
+	Min  time.Duration
+	Max  time.Duration
+}
+
+// OnStart implements sdktrace.SpanProcessor.
+func (f DurationFilter) OnStart(ctx context.Context, s sdktrace.ReadWriteSpan) {
+	f.Next.OnStart(ctx, s)
+}
+
+// OnEnd implements sdktrace.SpanProcessor.
+func (f DurationFilter) OnEnd(s sdktrace.ReadOnlySpan) {
+	duration := s.EndTime().Sub(s.StartTime())
+	if duration < f.Min || duration > f.Max {
+		return
+	}
+	f.Next.OnEnd(s)
+}
+
+// Shutdown implements sdktrace.SpanProcessor.
+func (f DurationFilter) Shutdown(ctx context.Context) error {
+	return f.Next.Shutdown(ctx)
+}
+
+// ForceFlush implements sdktrace.SpanProcessor.
+func (f DurationFilter) ForceFlush(ctx context.Context) error {
+	return f.Next.ForceFlush(ctx)
+}
@@ -0,0 +1,61 @@
+// Package grpc provides OpenTelemetry server and client instrumentation for
+// google.golang.org/grpc, built on top of otelgrpc's stats.Handler with a
+// Filter hook so callers can skip instrumenting methods such as health
+// checks.
+package grpc
+
+import (
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// Filter reports whether a given gRPC method should be instrumented, e.g.
+// skipping grpc.health.v1.Health/Check.
+type Filter func(fullMethod string) bool
+
+type options struct {
+	otelOpts []otelgrpc.Option
+}
+
+// Option configures ServerHandler and ClientHandler.
+type Option interface {
+	apply(*options)
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) { f(o) }
+
+// WithFilter skips instrumentation for methods for which filter returns
+// false.
+func WithFilter(filter Filter) Option {
+	return optionFunc(func(o *options) {
+		o.otelOpts = append(o.otelOpts, otelgrpc.WithFilter(func(info *stats.RPCTagInfo) bool {
+			return filter(info.FullMethodName)
+		}))
+	})
+}
+
+func applyOptions(opts ...Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt.apply(o)
+	}
+	return o
+}
+
+// ServerHandler returns a grpc.ServerOption that traces unary and streaming
+// RPCs received by a grpc.Server, respecting whichever propagator is
+// globally configured.
+func ServerHandler(opts ...Option) grpc.ServerOption {
+	o := applyOptions(opts...)
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(o.otelOpts...))
+}
+
+// ClientHandler returns a grpc.DialOption that traces outbound unary and
+// streaming RPCs made over a grpc.ClientConn.
+func ClientHandler(opts ...Option) grpc.DialOption {
+	o := applyOptions(opts...)
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(o.otelOpts...))
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import "testing"
+
+func TestApplyOptionsWithFilter(t *testing.T) {
+	o := applyOptions()
+	if len(o.otelOpts) != 0 {
+		t.Fatalf("applyOptions() with no opts = %d otelOpts, want 0", len(o.otelOpts))
+	}
+
+	o = applyOptions(WithFilter(func(fullMethod string) bool { return fullMethod != "/health" }))
+	if len(o.otelOpts) != 1 {
+		t.Fatalf("applyOptions(WithFilter(...)) = %d otelOpts, want 1", len(o.otelOpts))
+	}
+}
+
+func TestServerHandlerAndClientHandlerReturnNonNil(t *testing.T) {
+	if ServerHandler() == nil {
+		t.Error("ServerHandler() = nil")
+	}
+	if ClientHandler() == nil {
+		t.Error("ClientHandler() = nil")
+	}
+	if ServerHandler(WithFilter(func(string) bool { return true })) == nil {
+		t.Error("ServerHandler(WithFilter(...)) = nil")
+	}
+	if ClientHandler(WithFilter(func(string) bool { return true })) == nil {
+		t.Error("ClientHandler(WithFilter(...)) = nil")
+	}
+}
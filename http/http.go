@@ -0,0 +1,113 @@
+// Package http provides OpenTelemetry server and client instrumentation for
+// net/http, built on top of otelhttp but with this module's own span
+// naming and filtering conventions layered on.
+package http
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Filter reports whether an inbound request should be instrumented. It is
+// typically used to skip health-check or metrics endpoints.
+type Filter func(*http.Request) bool
+
+// SpanNameFormatter builds the span name for an inbound request. Callers
+// that have access to a router's matched route (chi, gorilla/mux, ...)
+// should supply one via WithSpanNameFormatter so spans read e.g.
+// "GET /users/{id}" instead of one span per distinct id.
+type SpanNameFormatter func(r *http.Request) string
+
+func defaultSpanNameFormatter(r *http.Request) string {
+	return r.Method + " " + r.URL.Path
+}
+
+type options struct {
+	filter            Filter
+	spanNameFormatter SpanNameFormatter
+	tracerProvider    trace.TracerProvider
+}
+
+// Option configures Handler and Transport.
+type Option interface {
+	apply(*options)
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) { f(o) }
+
+// WithFilter skips instrumentation for requests for which filter returns
+// false.
+func WithFilter(filter Filter) Option {
+	return optionFunc(func(o *options) { o.filter = filter })
+}
+
+// WithSpanNameFormatter overrides the default "METHOD path" span name.
+func WithSpanNameFormatter(fn SpanNameFormatter) Option {
+	return optionFunc(func(o *options) { o.spanNameFormatter = fn })
+}
+
+// WithTracerProvider overrides the global TracerProvider otherwise used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return optionFunc(func(o *options) { o.tracerProvider = tp })
+}
+
+func applyOptions(opts ...Option) *options {
+	o := &options{spanNameFormatter: defaultSpanNameFormatter}
+	for _, opt := range opts {
+		opt.apply(o)
+	}
+	return o
+}
+
+// Handler wraps next with OpenTelemetry server-side instrumentation. Spans
+// produced here flow through whatever SpanProcessor chain tracing.Init
+// configured, including the duration filter when enabled.
+func Handler(next http.Handler, opts ...Option) http.Handler {
+	o := applyOptions(opts...)
+
+	otelOpts := []otelhttp.Option{
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return o.spanNameFormatter(r)
+		}),
+	}
+	if o.tracerProvider != nil {
+		otelOpts = append(otelOpts, otelhttp.WithTracerProvider(o.tracerProvider))
+	}
+
+	wrapped := otelhttp.NewHandler(next, "http.server", otelOpts...)
+	if o.filter == nil {
+		return wrapped
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !o.filter(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		wrapped.ServeHTTP(w, r)
+	})
+}
+
+// Transport wraps base with OpenTelemetry client-side instrumentation for
+// outbound requests. If base is nil, http.DefaultTransport is used.
+func Transport(base http.RoundTripper, opts ...Option) http.RoundTripper {
+	o := applyOptions(opts...)
+
+	otelOpts := []otelhttp.Option{
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return o.spanNameFormatter(r)
+		}),
+	}
+	if o.tracerProvider != nil {
+		otelOpts = append(otelOpts, otelhttp.WithTracerProvider(o.tracerProvider))
+	}
+	if o.filter != nil {
+		otelOpts = append(otelOpts, otelhttp.WithFilter(otelhttp.Filter(o.filter)))
+	}
+
+	return otelhttp.NewTransport(base, otelOpts...)
+}
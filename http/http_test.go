@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// countingProcessor counts every span OnEnd sees, so tests can assert a
+// filtered-out request produced no span without asserting on otelhttp
+// internals.
+type countingProcessor struct {
+	n atomic.Int64
+}
+
+func (p *countingProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (p *countingProcessor) OnEnd(sdktrace.ReadOnlySpan)                    { p.n.Add(1) }
+func (p *countingProcessor) Shutdown(context.Context) error                 { return nil }
+func (p *countingProcessor) ForceFlush(context.Context) error               { return nil }
+
+func TestHandlerHonorsFilter(t *testing.T) {
+	counter := &countingProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(counter))
+	defer tp.Shutdown(context.Background())
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := Handler(next,
+		WithTracerProvider(tp),
+		WithFilter(func(r *http.Request) bool { return r.URL.Path != "/healthz" }),
+	)
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/healthz")
+	if err != nil {
+		t.Fatalf("GET /healthz: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = http.Get(srv.URL + "/users")
+	if err != nil {
+		t.Fatalf("GET /users: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := counter.n.Load(); got != 1 {
+		t.Errorf("spans recorded = %d, want 1 (only the non-filtered request)", got)
+	}
+}
+
+func TestTransportHonorsFilter(t *testing.T) {
+	counter := &countingProcessor{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(counter))
+	defer tp.Shutdown(context.Background())
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{
+		Transport: Transport(nil,
+			WithTracerProvider(tp),
+			WithFilter(func(r *http.Request) bool { return r.URL.Path != "/skip" }),
+		),
+	}
+
+	resp, err := client.Get(upstream.URL + "/skip")
+	if err != nil {
+		t.Fatalf("GET /skip: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Get(upstream.URL + "/keep")
+	if err != nil {
+		t.Fatalf("GET /keep: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := counter.n.Load(); got != 1 {
+		t.Errorf("spans recorded = %d, want 1 (WithFilter should have skipped /skip)", got)
+	}
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Option configures InitOtlpTracerProvider.
+type Option interface {
+	apply(*options)
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) { f(o) }
+
+type options struct {
+	otelGrpcEndpoint      string
+	serviceName           string
+	serviceVersion        string
+	deploymentEnvironment string
+	attributes            []attribute.KeyValue
+
+	durationFilter bool
+	durationMin    time.Duration
+	durationMax    time.Duration
+
+	propagators []Propagator
+}
+
+// WithOtelGrpcEndpoint sets the OTLP/gRPC collector endpoint spans are
+// exported to.
+func WithOtelGrpcEndpoint(endpoint string) Option {
+	return optionFunc(func(o *options) { o.otelGrpcEndpoint = endpoint })
+}
+
+// WithSerivceName sets the service.name resource attribute.
+func WithSerivceName(name string) Option {
+	return optionFunc(func(o *options) { o.serviceName = name })
+}
+
+// WithServiceVersion sets the service.version resource attribute.
+func WithServiceVersion(version string) Option {
+	return optionFunc(func(o *options) { o.serviceVersion = version })
+}
+
+// WithDeploymentEnvironment sets the deployment.environment resource
+// attribute.
+func WithDeploymentEnvironment(env string) Option {
+	return optionFunc(func(o *options) { o.deploymentEnvironment = env })
+}
+
+// WithAttributes adds extra resource attributes.
+func WithAttributes(attrs ...attribute.KeyValue) Option {
+	return optionFunc(func(o *options) { o.attributes = append(o.attributes, attrs...) })
+}
+
+// WithDurationFilter only lets spans whose duration falls within [min, max]
+// reach the configured exporter.
+func WithDurationFilter(min, max time.Duration) Option {
+	return optionFunc(func(o *options) {
+		o.durationFilter = true
+		o.durationMin = min
+		o.durationMax = max
+	})
+}
+
+// WithPropagators sets the TextMapPropagators composed into the global
+// propagator. When omitted, InitOtlpTracerProvider keeps using B3 (multi
+// header) for backward compatibility.
+func WithPropagators(propagators ...Propagator) Option {
+	return optionFunc(func(o *options) { o.propagators = propagators })
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/contrib/propagators/jaeger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Propagator identifies a supported propagation.TextMapPropagator that can
+// be composed into the globally installed one via Config.Propagators or
+// WithPropagators.
+type Propagator string
+
+const (
+	PropagatorTraceContext Propagator = "tracecontext"
+	PropagatorBaggage      Propagator = "baggage"
+	PropagatorB3Single     Propagator = "b3-single"
+	PropagatorB3Multi      Propagator = "b3-multi"
+	PropagatorJaeger       Propagator = "jaeger"
+)
+
+// defaultPropagators matches the W3C-only behavior InitStdoutTracerProvider
+// has always used.
+var defaultPropagators = []Propagator{PropagatorTraceContext, PropagatorBaggage}
+
+func buildPropagator(propagators []Propagator) (propagation.TextMapPropagator, error) {
+	if len(propagators) == 0 {
+		propagators = defaultPropagators
+	}
+
+	tmps := make([]propagation.TextMapPropagator, 0, len(propagators))
+	for _, p := range propagators {
+		switch p {
+		case PropagatorTraceContext:
+			tmps = append(tmps, propagation.TraceContext{})
+		case PropagatorBaggage:
+			tmps = append(tmps, propagation.Baggage{})
+		case PropagatorB3Single:
+			tmps = append(tmps, b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)))
+		case PropagatorB3Multi:
+			tmps = append(tmps, b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)))
+		case PropagatorJaeger:
+			tmps = append(tmps, jaeger.Jaeger{})
+		default:
+			return nil, fmt.Errorf("tracing: unknown propagator %q", p)
+		}
+	}
+	return propagation.NewCompositeTextMapPropagator(tmps...), nil
+}
+
+// NewSpanFromCarrier extracts a span context from carrier using the
+// globally configured propagator (see Config.Propagators / WithPropagators)
+// and returns the resulting trace.Span. Unlike NewSpanFromB3, it works
+// whether the caller propagates B3, W3C traceparent, or Jaeger
+// uber-trace-id headers, since it defers to whatever Init set up.
+func NewSpanFromCarrier(ctx context.Context, carrier propagation.TextMapCarrier) trace.Span {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	return trace.SpanFromContext(ctx)
+}
+
+// NewSpanFromB3 extracts a span context from B3 headers.
+//
+// Deprecated: use NewSpanFromCarrier with propagation.HeaderCarrier
+// instead; it honors whichever propagator the service is configured with
+// rather than hard-coding B3.
+func NewSpanFromB3(ctx context.Context, header http.Header) trace.Span {
+	propagator := b3.New()
+	ctx = propagator.Extract(ctx, propagation.HeaderCarrier(header))
+	return trace.SpanFromContext(ctx)
+}
@@ -0,0 +1,32 @@
+package tracing
+
+import "testing"
+
+func TestBuildPropagator(t *testing.T) {
+	tests := []struct {
+		name        string
+		propagators []Propagator
+		wantErr     bool
+	}{
+		{"empty falls back to defaults", nil, false},
+		{"tracecontext", []Propagator{PropagatorTraceContext}, false},
+		{"baggage", []Propagator{PropagatorBaggage}, false},
+		{"b3 single", []Propagator{PropagatorB3Single}, false},
+		{"b3 multi", []Propagator{PropagatorB3Multi}, false},
+		{"jaeger", []Propagator{PropagatorJaeger}, false},
+		{"composed", []Propagator{PropagatorTraceContext, PropagatorBaggage}, false},
+		{"unknown propagator", []Propagator{"bogus"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPropagator(tt.propagators)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildPropagator(%v) error = %v, wantErr %v", tt.propagators, err, tt.wantErr)
+			}
+			if err == nil && got == nil {
+				t.Errorf("buildPropagator(%v) = nil propagator, want non-nil", tt.propagators)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package recorder lets a server capture every span belonging to a trace
+// produced during a single unit of work, so it can hand them back to a
+// client (e.g. a CLI tool or CI job) instead of only pushing them to a
+// collector.
+package recorder
+
+import (
+	"context"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordingSpanProcessor buffers spans in memory, keyed by trace ID, for
+// any trace that has an active recording. Install it alongside the batch
+// processor used for normal export; it never drops or mutates spans, it
+// only observes them.
+type RecordingSpanProcessor struct {
+	mu        sync.Mutex
+	byTraceID map[trace.TraceID][]sdktrace.ReadOnlySpan
+}
+
+// NewRecordingSpanProcessor returns a ready-to-use RecordingSpanProcessor.
+func NewRecordingSpanProcessor() *RecordingSpanProcessor {
+	return &RecordingSpanProcessor{byTraceID: make(map[trace.TraceID][]sdktrace.ReadOnlySpan)}
+}
+
+// OnStart implements sdktrace.SpanProcessor.
+func (p *RecordingSpanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd implements sdktrace.SpanProcessor.
+func (p *RecordingSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	traceID := s.SpanContext().TraceID()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, recording := p.byTraceID[traceID]; !recording {
+		return
+	}
+	p.byTraceID[traceID] = append(p.byTraceID[traceID], s)
+}
+
+// Shutdown implements sdktrace.SpanProcessor.
+func (p *RecordingSpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush implements sdktrace.SpanProcessor.
+func (p *RecordingSpanProcessor) ForceFlush(context.Context) error { return nil }
+
+// StartRecording begins capturing every span belonging to the trace active
+// in ctx. ctx must already contain a valid span (e.g. one created by
+// tracing.Start), since spans are correlated by trace ID rather than by
+// parentage.
+func (p *RecordingSpanProcessor) StartRecording(ctx context.Context) RecordingHandle {
+	traceID := trace.SpanContextFromContext(ctx).TraceID()
+
+	p.mu.Lock()
+	p.byTraceID[traceID] = nil
+	p.mu.Unlock()
+
+	return RecordingHandle{processor: p, traceID: traceID}
+}
+
+// RecordingHandle represents one in-flight recording of a trace.
+type RecordingHandle struct {
+	processor *RecordingSpanProcessor
+	traceID   trace.TraceID
+}
+
+// Finish stops the recording and returns every span captured for its trace
+// ID, in the order they ended. Calling Finish more than once returns no
+// further spans.
+func (h RecordingHandle) Finish() []sdktrace.ReadOnlySpan {
+	h.processor.mu.Lock()
+	defer h.processor.mu.Unlock()
+
+	spans := h.processor.byTraceID[h.traceID]
+	delete(h.processor.byTraceID, h.traceID)
+	return spans
+}
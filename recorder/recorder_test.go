@@ -0,0 +1,76 @@
+package recorder
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func newTestTracerProvider(rp *RecordingSpanProcessor) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rp))
+}
+
+func TestRecordingSpanProcessor(t *testing.T) {
+	rp := NewRecordingSpanProcessor()
+	tp := newTestTracerProvider(rp)
+	defer tp.Shutdown(context.Background())
+	tracer := tp.Tracer("recorder_test")
+
+	// Spans that ended before any recording started must never show up.
+	_, preSpan := tracer.Start(context.Background(), "before-recording")
+	preSpan.End()
+
+	ctx, rootSpan := tracer.Start(context.Background(), "root")
+	handle := rp.StartRecording(ctx)
+
+	_, childSpan := tracer.Start(ctx, "child")
+	childSpan.End()
+	rootSpan.End()
+
+	spans := handle.Finish()
+	if len(spans) != 2 {
+		t.Fatalf("Finish() returned %d spans, want 2", len(spans))
+	}
+	if got := []string{spans[0].Name(), spans[1].Name()}; got[0] != "child" || got[1] != "root" {
+		t.Errorf("Finish() spans = %v, want [child root] (end order)", got)
+	}
+}
+
+func TestRecordingHandleFinishIsOneShot(t *testing.T) {
+	rp := NewRecordingSpanProcessor()
+	tp := newTestTracerProvider(rp)
+	defer tp.Shutdown(context.Background())
+	tracer := tp.Tracer("recorder_test")
+
+	ctx, span := tracer.Start(context.Background(), "root")
+	handle := rp.StartRecording(ctx)
+	span.End()
+
+	if first := handle.Finish(); len(first) != 1 {
+		t.Fatalf("first Finish() = %d spans, want 1", len(first))
+	}
+	if second := handle.Finish(); len(second) != 0 {
+		t.Errorf("second Finish() = %d spans, want 0 (already drained)", len(second))
+	}
+}
+
+func TestRecordingIsolatedByTraceID(t *testing.T) {
+	rp := NewRecordingSpanProcessor()
+	tp := newTestTracerProvider(rp)
+	defer tp.Shutdown(context.Background())
+	tracer := tp.Tracer("recorder_test")
+
+	ctxA, spanA := tracer.Start(context.Background(), "trace-a-root")
+	handleA := rp.StartRecording(ctxA)
+
+	// A second, unrelated trace must not leak into handleA's results.
+	_, spanB := tracer.Start(context.Background(), "trace-b-root")
+	spanB.End()
+	spanA.End()
+
+	spans := handleA.Finish()
+	if len(spans) != 1 || spans[0].Name() != "trace-a-root" {
+		t.Errorf("Finish() = %v, want only [trace-a-root]", spans)
+	}
+}
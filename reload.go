@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/ttys3/lgr"
+)
+
+var (
+	// reloadMu serializes Reload calls so a config-watcher goroutine can't
+	// race itself into applying two reloads concurrently.
+	reloadMu sync.Mutex
+
+	// currentConfigMu guards currentConfig, which both Init and Reload
+	// write to, so Reload can diff against whatever was last actually
+	// applied regardless of which of the two last ran.
+	currentConfigMu sync.Mutex
+	currentConfig   Config
+
+	reloadErrCh = make(chan error, 1)
+)
+
+func setCurrentConfig(cfg Config) {
+	currentConfigMu.Lock()
+	currentConfig = cfg
+	currentConfigMu.Unlock()
+}
+
+func getCurrentConfig() Config {
+	currentConfigMu.Lock()
+	defer currentConfigMu.Unlock()
+	return currentConfig
+}
+
+// Reload swaps the global TracerProvider for one built from cfg, but only if
+// cfg differs materially from whatever config was last applied via Init or
+// Reload. This lets callers wire Reload up to a config watcher or a SIGHUP
+// handler without worrying about tearing down a healthy provider on every
+// tick.
+//
+// Reload errors are also pushed (non-blocking) onto the channel returned by
+// ReloadErrors, so callers that would rather observe failures asynchronously
+// don't have to thread the error through their own config-watch loop.
+func Reload(ctx context.Context, cfg Config) error {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	// Fill in env-detected fields the same way Init does, so cfg is compared
+	// and validated as Init would apply it.
+	if cfg.Enabled && cfg.Endpoint == "" {
+		cfg = mergeDetected(cfg, DetectFromEnv())
+	}
+
+	if !hasChange(getCurrentConfig(), cfg) {
+		return nil
+	}
+
+	if !cfg.Enabled {
+		if err := TracerProviderShutdown(ctx); err != nil {
+			lgr.S().Warn("[tracing] shutdown previous tracer provider failed", "err", err)
+		}
+		setCurrentConfig(cfg)
+		return nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		pushReloadErr(err)
+		return err
+	}
+
+	if err := TracerProviderShutdown(ctx); err != nil {
+		lgr.S().Warn("[tracing] shutdown previous tracer provider failed", "err", err)
+	}
+
+	// Init records the config it applied via setCurrentConfig, so there is
+	// nothing more to record here.
+	shutdown, err := Init(ctx, cfg)
+	if err != nil {
+		pushReloadErr(err)
+		return err
+	}
+	_ = shutdown
+
+	return nil
+}
+
+// ReloadErrors returns a channel that receives errors produced by Reload.
+// It is buffered by one slot; a reload error that arrives while the channel
+// is already full is dropped rather than blocking the reloader.
+func ReloadErrors() <-chan error {
+	return reloadErrCh
+}
+
+func pushReloadErr(err error) {
+	select {
+	case reloadErrCh <- err:
+	default:
+	}
+}
+
+// hasChange reports whether new differs from old in any way that requires
+// tearing down and rebuilding the TracerProvider.
+func hasChange(old, new Config) bool {
+	if old.Enabled != new.Enabled {
+		return true
+	}
+	if !new.Enabled {
+		return false
+	}
+	if old.Exporter != new.Exporter ||
+		old.Endpoint != new.Endpoint ||
+		old.insecure() != new.insecure() ||
+		old.ServiceName != new.ServiceName ||
+		old.ServiceVersion != new.ServiceVersion ||
+		old.ServiceInstanceID != new.ServiceInstanceID ||
+		old.DeploymentEnvironment != new.DeploymentEnvironment ||
+		old.SamplerRatio != new.SamplerRatio ||
+		old.DurationFilter != new.DurationFilter ||
+		old.DurationMin != new.DurationMin ||
+		old.DurationMax != new.DurationMax {
+		return true
+	}
+	if !reflect.DeepEqual(old.Headers, new.Headers) {
+		return true
+	}
+	if !reflect.DeepEqual(old.Attributes, new.Attributes) {
+		return true
+	}
+	if !reflect.DeepEqual(old.Propagators, new.Propagators) {
+		return true
+	}
+	if !reflect.DeepEqual(old.ExtraSpanProcessors, new.ExtraSpanProcessors) {
+		return true
+	}
+	return false
+}
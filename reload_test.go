@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestHasChange(t *testing.T) {
+	base := Config{
+		Enabled:     true,
+		Exporter:    ExporterOTLPGRPC,
+		Endpoint:    "collector:4317",
+		ServiceName: "svc",
+	}
+
+	endpointChanged := base
+	endpointChanged.Endpoint = "collector:4318"
+
+	headersChanged := base
+	headersChanged.Headers = map[string]string{"x": "y"}
+
+	attributesChanged := base
+	attributesChanged.Attributes = []attribute.KeyValue{attribute.String("env", "prod")}
+
+	tests := []struct {
+		name string
+		old  Config
+		new  Config
+		want bool
+	}{
+		{"identical", base, base, false},
+		{"enabled flipped off", base, Config{Enabled: false}, true},
+		{"both disabled, rest differs", Config{Enabled: false, ServiceName: "a"}, Config{Enabled: false, ServiceName: "b"}, false},
+		{"endpoint changed", base, endpointChanged, true},
+		{"headers changed", base, headersChanged, true},
+		{"attributes changed", base, attributesChanged, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasChange(tt.old, tt.new); got != tt.want {
+				t.Errorf("hasChange(%+v, %+v) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReloadDisableDoesNotValidate(t *testing.T) {
+	setCurrentConfig(Config{
+		Enabled:     true,
+		Exporter:    ExporterOTLPGRPC,
+		Endpoint:    "collector:4317",
+		ServiceName: "svc",
+	})
+
+	// An empty, disabled Config would fail Validate (no ServiceName/Exporter)
+	// if Reload didn't short-circuit before calling it.
+	if err := Reload(context.Background(), Config{Enabled: false}); err != nil {
+		t.Fatalf("Reload(Enabled: false) = %v, want nil", err)
+	}
+
+	if got := getCurrentConfig(); got.Enabled {
+		t.Errorf("getCurrentConfig().Enabled = true, want false after disabling reload")
+	}
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/ttys3/lgr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mirrorDisabledKey struct{}
+
+// WithoutLogMirror returns a context that suppresses log mirroring for spans
+// started with it. Use it on hot paths where logging every AddEvent,
+// SetAttributes or RecordError call would be too noisy or expensive.
+func WithoutLogMirror(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mirrorDisabledKey{}, true)
+}
+
+func mirrorDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(mirrorDisabledKey{}).(bool)
+	return disabled
+}
+
+// loggingSpan wraps a trace.Span so that AddEvent, SetAttributes and
+// RecordError also emit a structured log line via lgr, carrying the
+// trace_id/span_id so logs and traces can be cross-referenced without a
+// collector.
+type loggingSpan struct {
+	trace.Span
+	ctx context.Context
+}
+
+func (s *loggingSpan) AddEvent(name string, opts ...trace.EventOption) {
+	s.Span.AddEvent(name, opts...)
+	lgr.S().Debug("[tracing] span event", append(s.baseFields(), "event", name)...)
+}
+
+func (s *loggingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.Span.SetAttributes(kv...)
+	lgr.S().Debug("[tracing] span attributes", append(s.baseFields(), flattenAttributes(kv)...)...)
+}
+
+func (s *loggingSpan) RecordError(err error, opts ...trace.EventOption) {
+	s.Span.RecordError(err, opts...)
+	lgr.S().Debug("[tracing] span error", append(s.baseFields(), "err", err)...)
+}
+
+func (s *loggingSpan) baseFields() []interface{} {
+	return []interface{}{
+		"trace_id", TraceID(s.ctx),
+		"span_id", SpanID(s.ctx),
+	}
+}
+
+func flattenAttributes(kv []attribute.KeyValue) []interface{} {
+	fields := make([]interface{}, 0, len(kv)*2)
+	for _, a := range kv {
+		fields = append(fields, string(a.Key), a.Value.AsInterface())
+	}
+	return fields
+}
+
+// callerAttributes captures the file/line/function skip frames up the stack
+// from callerAttributes itself (1 is its caller, 2 the caller's caller), so a
+// span can self-document where it was started from.
+func callerAttributes(skip int) []attribute.KeyValue {
+	pc, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return nil
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("code.filepath", file),
+		attribute.Int("code.lineno", line),
+	}
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		attrs = append(attrs, attribute.String("code.function", fn.Name()))
+	}
+	return attrs
+}
@@ -2,21 +2,13 @@ package tracing
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 	"time"
 
 	"github.com/ttys3/lgr"
-	"github.com/ttys3/tracing/filter"
-	"go.opentelemetry.io/contrib/propagators/b3"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
-	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -48,74 +40,31 @@ func applayOptions(opts ...Option) *options {
 }
 
 // InitOtlpTracerProvider init a tracer provider with otlp exporter with B3 propagator
+//
+// Deprecated: build a Config and call Init instead. This is kept as a thin
+// shim over Init for existing callers.
 func InitOtlpTracerProvider(ctx context.Context, opts ...Option) (TpShutdownFunc, error) {
-	otel.SetErrorHandler(&otelErrorHandler{})
-
 	opt := applayOptions(opts...)
 
-	expOptions := []otlptracegrpc.Option{
-		otlptracegrpc.WithInsecure(),
-		otlptracegrpc.WithEndpoint(opt.otelGrpcEndpoint),
-	}
-
-	grpcConnectionTimeout := 3 * time.Second
-	var cancel context.CancelFunc
-	ctx, cancel = context.WithTimeout(ctx, grpcConnectionTimeout)
-	defer cancel()
-
-	traceExp, err := otlptracegrpc.New(ctx, expOptions...)
-	if err != nil {
-		return emptyTpShutdownFunc, fmt.Errorf("failed to create the collector trace exporter (%w)", err)
-	}
-
-	attrs := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(opt.serviceName),
-		semconv.ServiceVersionKey.String(opt.serviceVersion),
+	propagators := opt.propagators
+	if len(propagators) == 0 {
+		propagators = []Propagator{PropagatorB3Multi}
 	}
-	if opt.deploymentEnvironment != "" {
-		attrs = append(attrs, semconv.DeploymentEnvironmentKey.String(opt.deploymentEnvironment))
-	}
-	attrs = append(attrs, opt.attributes...)
-
-	res, err := resource.New(ctx,
-		resource.WithAttributes(attrs...),
-	)
-	if err != nil {
-		return emptyTpShutdownFunc, fmt.Errorf("failed to create resource (%w)", err)
-	}
-
-	// sdktrace.WithBatcher(traceExp,
-	// sdktrace.WithBatchTimeout(5*time.Second),
-	// sdktrace.WithMaxExportBatchSize(10)),
-	batchProcessor := sdktrace.NewBatchSpanProcessor(traceExp,
-		sdktrace.WithBatchTimeout(5*time.Second),
-		sdktrace.WithMaxExportBatchSize(10),
-	)
 
-	spanProcessor := batchProcessor
-	if opt.durationFilter {
-		// Build a SpanProcessor chain to only allow spans shorter than
-		// an minute and longer than a second to be exported with the exportSP.
-		spanProcessor = filter.DurationFilter{
-			Next: batchProcessor,
-			Min:  opt.durationMin,
-			Max:  opt.durationMax,
-		}
-	}
-
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(1))),
-		sdktrace.WithSpanProcessor(spanProcessor),
-		sdktrace.WithResource(res),
-	)
-	otel.SetTracerProvider(tp)
-
-	propagator := b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader))
-	otel.SetTextMapPropagator(propagator)
-
-	// tracer = tp.Tracer("github.com/ttys3/tracing")
-
-	return tp.Shutdown, nil
+	return Init(ctx, Config{
+		Enabled:               true,
+		Exporter:              ExporterOTLPGRPC,
+		Endpoint:              opt.otelGrpcEndpoint,
+		Insecure:              Bool(true),
+		ServiceName:           opt.serviceName,
+		ServiceVersion:        opt.serviceVersion,
+		DeploymentEnvironment: opt.deploymentEnvironment,
+		Attributes:            opt.attributes,
+		DurationFilter:        opt.durationFilter,
+		DurationMin:           opt.durationMin,
+		DurationMax:           opt.durationMax,
+		Propagators:           propagators,
+	})
 }
 
 // InitStdoutTracerProvider is only for unit tests
@@ -149,10 +98,19 @@ func TracerProviderShutdown(ctx context.Context) error {
 // Span will be a child of that span, otherwise it will be a root span. This behavior
 // can be overridden by providing `WithNewRoot()` as a SpanOption, causing the
 // newly-created Span to be a root span even if `ctx` contains a Span.
+//
+// The returned Span also mirrors its AddEvent/SetAttributes/RecordError
+// calls into the lgr logger, and is tagged with the file/line/function it
+// was started from. Use WithoutLogMirror(ctx) on hot paths to skip this.
 func Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (ctxWithSpan context.Context, newSpan trace.Span) {
 	// nolint: forbidigo
 	ctxWithSpan, newSpan = otel.Tracer("github.com/ttys3/tracing").Start(ctx, spanName, opts...)
-	return
+	newSpan.SetAttributes(callerAttributes(2)...)
+
+	if mirrorDisabled(ctx) {
+		return ctxWithSpan, newSpan
+	}
+	return ctxWithSpan, &loggingSpan{Span: newSpan, ctx: ctxWithSpan}
 }
 
 func TraceID(ctx context.Context) string {
@@ -179,13 +137,6 @@ func CtxWithSpan(parent context.Context, span trace.Span) context.Context {
 	return trace.ContextWithSpan(parent, span)
 }
 
-func NewSpanFromB3(ctx context.Context, header http.Header) trace.Span {
-	propagator := b3.New()
-	ctx = propagator.Extract(ctx, propagation.HeaderCarrier(header))
-	sp := trace.SpanFromContext(ctx)
-	return sp
-}
-
 func Logger(ctx context.Context, keyValues ...interface{}) lgr.Logger {
 	kvs := []interface{}{
 		"trace_id", TraceID(ctx),